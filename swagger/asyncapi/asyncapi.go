@@ -0,0 +1,213 @@
+// Package asyncapi documents ronykit's non-REST (RPC/WebSocket) contracts,
+// which addOperation in the swagger package otherwise ignores outright:
+// each RPC predicate becomes an AsyncAPI channel, the input type becomes
+// the subscribe message payload, and the output type becomes the publish
+// message payload (a oneOf together with any PossibleErrors).
+package asyncapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/clubpay/ronycontrib/swagger"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// asyncapiGen renders the RPC/WebSocket-reachable contracts of a
+// []*desc.Service tree into an AsyncAPI 2.6 document, reusing the
+// reflection performed by swagger.BuildModel so struct definitions, enum
+// tags and embedded fields are rendered consistently with the REST specs.
+type asyncapiGen struct {
+	doc     *document
+	tagName string
+}
+
+func New(title, ver, desc string) *asyncapiGen {
+	return &asyncapiGen{
+		doc: &document{
+			AsyncAPI: "2.6.0",
+			Info: info{
+				Title:       title,
+				Version:     ver,
+				Description: desc,
+			},
+			Channels: map[string]*channel{},
+			Components: components{
+				Schemas: map[string]*schema{},
+			},
+		},
+	}
+}
+
+func (g *asyncapiGen) WithTag(tagName string) *asyncapiGen {
+	g.tagName = tagName
+
+	return g
+}
+
+func (g asyncapiGen) WriteToFile(filename string, services ...*desc.Service) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	return g.WriteTo(f, services...)
+}
+
+func (g asyncapiGen) WriteTo(w io.Writer, services ...*desc.Service) error {
+	m := swagger.BuildModel(g.tagName, services...)
+
+	for _, op := range m.Operations {
+		if op.Predicate == "" {
+			// REST-only contract; nothing to say in AsyncAPI.
+			continue
+		}
+
+		publishMessages := []*message{{Payload: refSchema(op.Output)}}
+		for _, e := range op.Errors {
+			publishMessages = append(publishMessages, &message{Payload: refSchema(e.Type)})
+		}
+
+		pub := &operation{OperationID: op.Name + "Publish"}
+		if len(publishMessages) == 1 {
+			pub.Message = publishMessages[0]
+		} else {
+			pub.Message = &message{OneOf: publishMessages}
+		}
+
+		g.doc.Channels[op.Predicate] = &channel{
+			Subscribe: &operation{
+				OperationID: op.Name + "Subscribe",
+				Message:     &message{Payload: refSchema(op.Input)},
+			},
+			Publish: pub,
+		}
+	}
+
+	names := make([]string, 0, len(m.Definitions))
+	for name := range m.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.doc.Components.Schemas[name] = renderSchema(m.Definitions[name])
+	}
+
+	out, err := json.MarshalIndent(g.doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(out)
+
+	return err
+}
+
+func refSchema(name string) *schema {
+	return &schema{Ref: fmt.Sprintf("#/components/schemas/%s", name)}
+}
+
+func renderSchema(def *swagger.Definition) *schema {
+	s := &schema{Type: "object", Properties: map[string]*schema{}}
+	for _, f := range def.Fields {
+		s.Properties[f.Name] = renderFieldType(f.Type)
+		if f.Required {
+			s.Required = append(s.Required, f.Name)
+		}
+	}
+
+	return s
+}
+
+func renderFieldType(t swagger.FieldType) *schema {
+	if t.Kind == "ref" {
+		return refSchema(t.Ref)
+	}
+
+	s := &schema{Format: t.Format, Nullable: t.Nullable}
+	switch t.Kind {
+	case "array":
+		s.Type = "array"
+		s.Items = renderFieldType(*t.Items)
+	case "integer", "number", "boolean", "object":
+		s.Type = t.Kind
+	default:
+		s.Type = "string"
+	}
+
+	if len(t.Enum) > 0 {
+		s.Enum = make([]interface{}, len(t.Enum))
+		for i, v := range t.Enum {
+			s.Enum[i] = swagger.CoerceEnumValue(t.Kind, v)
+		}
+	}
+	s.Minimum = t.Min
+	s.Maximum = t.Max
+	s.MinLength = t.MinLen
+	s.MaxLength = t.MaxLen
+	s.Pattern = t.Pattern
+	if t.Default != "" {
+		s.Default = swagger.CoerceEnumValue(t.Kind, t.Default)
+	}
+	if t.Example != "" {
+		s.Example = swagger.CoerceEnumValue(t.Kind, t.Example)
+	}
+
+	return s
+}
+
+// document is a minimal AsyncAPI 2.6 document, covering the subset this
+// package emits.
+type document struct {
+	AsyncAPI   string              `json:"asyncapi"`
+	Info       info                `json:"info"`
+	Channels   map[string]*channel `json:"channels"`
+	Components components          `json:"components"`
+}
+
+type info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type channel struct {
+	Subscribe *operation `json:"subscribe,omitempty"`
+	Publish   *operation `json:"publish,omitempty"`
+}
+
+type operation struct {
+	OperationID string   `json:"operationId"`
+	Message     *message `json:"message"`
+}
+
+type message struct {
+	Payload *schema    `json:"payload,omitempty"`
+	OneOf   []*message `json:"oneOf,omitempty"`
+}
+
+type components struct {
+	Schemas map[string]*schema `json:"schemas,omitempty"`
+}
+
+type schema struct {
+	Type       string             `json:"type,omitempty"`
+	Format     string             `json:"format,omitempty"`
+	Ref        string             `json:"$ref,omitempty"`
+	Items      *schema            `json:"items,omitempty"`
+	Properties map[string]*schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Nullable   bool               `json:"nullable,omitempty"`
+
+	Enum      []interface{} `json:"enum,omitempty"`
+	Minimum   *float64      `json:"minimum,omitempty"`
+	Maximum   *float64      `json:"maximum,omitempty"`
+	MinLength *int64        `json:"minLength,omitempty"`
+	MaxLength *int64        `json:"maxLength,omitempty"`
+	Pattern   string        `json:"pattern,omitempty"`
+	Default   interface{}   `json:"default,omitempty"`
+	Example   interface{}   `json:"example,omitempty"`
+}
@@ -0,0 +1,80 @@
+package asyncapi_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/clubpay/ronycontrib/swagger/asyncapi"
+	"github.com/clubpay/ronykit"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// testSelector is a minimal ronykit.RPCRouteSelector that doesn't require
+// pulling in a concrete gateway bundle, just to exercise the generator.
+type testSelector struct {
+	predicate string
+}
+
+func (s testSelector) Query(string) interface{} { return nil }
+func (s testSelector) GetPredicate() string     { return s.predicate }
+
+type eventReq struct {
+	X string `json:"x" swag:"enum:a,b"`
+}
+
+type eventRes struct {
+	Out int `json:"out"`
+}
+
+func testService() *desc.Service {
+	return (&desc.Service{Name: "eventService"}).
+		AddContract(
+			desc.NewContract().
+				SetName("OnEvent").
+				AddSelector(testSelector{predicate: "event.created"}).
+				SetInput(&eventReq{}).
+				SetOutput(&eventRes{}).
+				SetHandler(nil),
+		)
+}
+
+func TestWriteTo(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := asyncapi.New("TestTitle", "v0.0.1", "").WithTag("json").WriteTo(buf, testService()); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Channels map[string]struct {
+			Subscribe struct {
+				OperationID string `json:"operationId"`
+			} `json:"subscribe"`
+		} `json:"channels"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Enum []interface{} `json:"enum"`
+				} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	ch, ok := doc.Channels["event.created"]
+	if !ok {
+		t.Fatalf("expected a channel for predicate %q, got %v", "event.created", doc.Channels)
+	}
+	if ch.Subscribe.OperationID != "OnEventSubscribe" {
+		t.Errorf("unexpected subscribe operationId: %q", ch.Subscribe.OperationID)
+	}
+
+	enum := doc.Components.Schemas["eventReq"].Properties["x"].Enum
+	if len(enum) != 2 || enum[0] != "a" || enum[1] != "b" {
+		t.Errorf("expected eventReq.x enum [a b], got %v", enum)
+	}
+}
+
+var _ ronykit.RPCRouteSelector = testSelector{}
@@ -0,0 +1,102 @@
+package clientgen_test
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/clubpay/ronycontrib/swagger/clientgen"
+	"github.com/clubpay/ronykit"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// testSelector is a minimal ronykit.RESTRouteSelector that doesn't require
+// pulling in a concrete gateway bundle, just to exercise the generators.
+type testSelector struct {
+	method string
+	path   string
+}
+
+func (s testSelector) Query(string) interface{} { return nil }
+func (s testSelector) GetMethod() string        { return s.method }
+func (s testSelector) GetPath() string          { return s.path }
+
+type clientReq struct {
+	X string `json:"x"`
+	Y int64  `json:"y"`
+}
+
+type clientRes struct {
+	Out string `json:"out"`
+}
+
+type clientError struct {
+	Code int `json:"code"`
+}
+
+func testService() *desc.Service {
+	return (&desc.Service{Name: "clientTestService"}).
+		AddContract(
+			desc.NewContract().
+				SetName("GetThing").
+				AddSelector(testSelector{method: "GET", path: "/things/:x"}).
+				SetInput(&clientReq{}).
+				SetOutput(&clientRes{}).
+				AddPossibleError(404, "NOT_FOUND", &clientError{}).
+				SetHandler(nil),
+		).
+		// Two unnamed contracts on distinct routes; regression coverage for
+		// the "Field"/"call" naming collision fixed in chunk0-3.
+		AddContract(
+			desc.NewContract().
+				AddSelector(testSelector{method: "GET", path: "/unnamed/a"}).
+				SetInput(&clientReq{}).
+				SetOutput(&clientRes{}).
+				SetHandler(nil),
+		).
+		AddContract(
+			desc.NewContract().
+				AddSelector(testSelector{method: "POST", path: "/unnamed/b"}).
+				SetInput(&clientReq{}).
+				SetOutput(&clientRes{}).
+				SetHandler(nil),
+		)
+}
+
+func TestWriteGoClient(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := clientgen.NewGoClient("client").WithTag("json").WriteTo(buf, testService()); err != nil {
+		t.Fatal(err)
+	}
+
+	src := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "client.go", src, 0); err != nil {
+		t.Fatalf("generated Go client doesn't parse: %v\n%s", err, src)
+	}
+
+	if !strings.Contains(src, "func (c *Client) GetThing(") {
+		t.Error("expected a GetThing method for the named contract")
+	}
+	if strings.Count(src, "func (c *Client) Field(") > 0 {
+		t.Error("unnamed contracts should not fall back to the colliding \"Field\" name")
+	}
+}
+
+func TestWriteTSClient(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := clientgen.NewTSClient().WithTag("json").WriteTo(buf, testService()); err != nil {
+		t.Fatal(err)
+	}
+
+	src := buf.String()
+	if !strings.Contains(src, "getThing(") {
+		t.Error("expected a getThing method for the named contract")
+	}
+	if strings.Count(src, "call(") > 1 {
+		t.Error("unnamed contracts should not collapse onto the colliding \"call\" name")
+	}
+}
+
+var _ ronykit.RESTRouteSelector = testSelector{}
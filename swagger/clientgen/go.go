@@ -0,0 +1,253 @@
+// Package clientgen renders the same []*desc.Service tree the swagger
+// package documents into typed client SDKs, so teams that today run
+// oapi-codegen over the generated JSON can instead generate a client
+// directly from the service definition and skip the spec round-trip.
+package clientgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/clubpay/ronycontrib/swagger"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// goClientGen renders a typed Go client package, one method per REST
+// contract, reusing the reflection performed by swagger.BuildModel.
+type goClientGen struct {
+	pkgName string
+	tagName string
+}
+
+func NewGoClient(pkgName string) *goClientGen {
+	return &goClientGen{pkgName: pkgName, tagName: "json"}
+}
+
+func (g *goClientGen) WithTag(tagName string) *goClientGen {
+	g.tagName = tagName
+
+	return g
+}
+
+func (g goClientGen) WriteToFile(filename string, services ...*desc.Service) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	return g.WriteTo(f, services...)
+}
+
+func (g goClientGen) WriteTo(w io.Writer, services ...*desc.Service) error {
+	m := swagger.BuildModel(g.tagName, services...)
+
+	src := &bytes.Buffer{}
+	fmt.Fprintf(src, "// Code generated by swagger/clientgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(src, "package %s\n\n", g.pkgName)
+	fmt.Fprint(src, `import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+`)
+	writeGoClientType(src)
+
+	names := make([]string, 0, len(m.Definitions))
+	for name := range m.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeGoDefinition(src, m.Definitions[name])
+	}
+
+	for _, op := range m.Operations {
+		if op.Method == "" {
+			// Not REST-reachable (e.g. RPC/WebSocket-only); nothing to call over HTTP.
+			continue
+		}
+		writeGoOperation(src, op)
+	}
+
+	out, err := format.Source(src.Bytes())
+	if err != nil {
+		// Emit the unformatted source rather than hiding a codegen bug.
+		out = src.Bytes()
+	}
+
+	_, err = w.Write(out)
+
+	return err
+}
+
+func writeGoClientType(w io.Writer) {
+	fmt.Fprint(w, `// Client is a generated HTTP client for this service.
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient creates a Client pointed at baseURL, using http.DefaultClient.
+func NewClient(baseURL string) *Client {
+	return &Client{BaseURL: strings.TrimRight(baseURL, "/"), HTTPClient: http.DefaultClient}
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	var reqBody *bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewBuffer(b)
+	} else {
+		reqBody = bytes.NewBuffer(nil)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	return c.HTTPClient.Do(req)
+}
+
+`)
+}
+
+func writeGoDefinition(w io.Writer, def *swagger.Definition) {
+	fmt.Fprintf(w, "type %s struct {\n", def.Name)
+	for _, f := range def.Fields {
+		fmt.Fprintf(w, "\t%s %s `json:\"%s\"`\n", exportName(f.Name), goType(f.Type), f.Name)
+	}
+	fmt.Fprint(w, "}\n\n")
+}
+
+func writeGoOperation(w io.Writer, op *swagger.Operation) {
+	methodName := exportName(operationName(op))
+	errName := methodName + "Error"
+
+	if len(op.Errors) > 0 {
+		fmt.Fprintf(w, "// %s is returned by Client.%s for any non-2xx response.\n", errName, methodName)
+		fmt.Fprintf(w, "type %s struct {\n\tStatusCode int\n", errName)
+		seen := map[string]bool{}
+		for _, e := range op.Errors {
+			if seen[e.Type] {
+				continue
+			}
+			seen[e.Type] = true
+			fmt.Fprintf(w, "\t%s *%s\n", e.Type, e.Type)
+		}
+		fmt.Fprint(w, "}\n\n")
+		fmt.Fprintf(w, "func (e *%s) Error() string {\n\treturn fmt.Sprintf(\"%s: unexpected status %%d\", e.StatusCode)\n}\n\n",
+			errName, methodName)
+	}
+
+	fmt.Fprintf(w, "func (c *Client) %s(ctx context.Context, in *%s) (*%s, error) {\n", methodName, op.Input, op.Output)
+	fmt.Fprintf(w, "\tpath := %q\n", op.Path)
+
+	var bodyArg string
+	switch op.Method {
+	case "POST", "PUT", "PATCH":
+		bodyArg = "in"
+	default:
+		bodyArg = "nil"
+	}
+
+	hasQuery := false
+	for _, p := range op.Params {
+		if p.In == "path" {
+			fmt.Fprintf(w, "\tpath = strings.Replace(path, %q, fmt.Sprintf(\"%%v\", in.%s), 1)\n",
+				":"+p.Name, exportName(p.Name))
+		} else {
+			hasQuery = true
+		}
+	}
+
+	if hasQuery {
+		fmt.Fprint(w, "\tq := url.Values{}\n")
+		for _, p := range op.Params {
+			if p.In != "query" {
+				continue
+			}
+			fmt.Fprintf(w, "\tq.Set(%q, fmt.Sprintf(\"%%v\", in.%s))\n", p.Name, exportName(p.Name))
+		}
+		fmt.Fprint(w, "\tpath += \"?\" + q.Encode()\n")
+	}
+
+	fmt.Fprintf(w, "\tresp, err := c.do(ctx, %q, path, %s)\n", op.Method, bodyArg)
+	fmt.Fprint(w, "\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprint(w, "\tdefer resp.Body.Close()\n\n")
+
+	fmt.Fprint(w, "\tif resp.StatusCode >= 200 && resp.StatusCode < 300 {\n")
+	fmt.Fprintf(w, "\t\tout := &%s{}\n", op.Output)
+	fmt.Fprint(w, "\t\tif err := json.NewDecoder(resp.Body).Decode(out); err != nil {\n\t\t\treturn nil, err\n\t\t}\n")
+	fmt.Fprint(w, "\t\treturn out, nil\n\t}\n\n")
+
+	if len(op.Errors) > 0 {
+		fmt.Fprintf(w, "\tapiErr := &%s{StatusCode: resp.StatusCode}\n", errName)
+		fmt.Fprint(w, "\tswitch resp.StatusCode {\n")
+		for _, e := range op.Errors {
+			fmt.Fprintf(w, "\tcase %d:\n", e.Code)
+			fmt.Fprintf(w, "\t\tv := &%s{}\n\t\t_ = json.NewDecoder(resp.Body).Decode(v)\n\t\tapiErr.%s = v\n", e.Type, e.Type)
+		}
+		fmt.Fprint(w, "\t}\n\n\treturn nil, apiErr\n}\n\n")
+	} else {
+		fmt.Fprintf(w, "\treturn nil, fmt.Errorf(\"%s: unexpected status %%d\", resp.StatusCode)\n}\n\n", methodName)
+	}
+}
+
+func goType(t swagger.FieldType) string {
+	base := goBaseType(t)
+	if t.Nullable && !strings.HasPrefix(base, "[]") && base != "interface{}" {
+		return "*" + base
+	}
+
+	return base
+}
+
+func goBaseType(t swagger.FieldType) string {
+	switch t.Kind {
+	case "string":
+		if t.Format == "byte" {
+			return "[]byte"
+		}
+
+		return "string"
+	case "integer":
+		switch t.Format {
+		case "int8":
+			return "int8"
+		case "int32":
+			return "int32"
+		default:
+			return "int64"
+		}
+	case "number":
+		if t.Format == "float" {
+			return "float32"
+		}
+
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + goType(*t.Items)
+	case "ref":
+		return t.Ref
+	default:
+		return "interface{}"
+	}
+}
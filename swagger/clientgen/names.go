@@ -0,0 +1,53 @@
+package clientgen
+
+import (
+	"strings"
+
+	"github.com/clubpay/ronycontrib/swagger"
+)
+
+// operationName returns op.Name, or, for the unnamed desc.Contract case
+// (Name defaults to "" unless SetName is called), a stable name synthesized
+// from its HTTP method and path, e.g. "GET" + "/users/:id" -> "getUsersId".
+// Falling back to a constant like "Field"/"call" instead would collide and
+// produce a client with redeclared methods/types for any service with more
+// than one unnamed contract.
+func operationName(op *swagger.Operation) string {
+	if op.Name != "" {
+		return op.Name
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.ToLower(op.Method))
+	for _, p := range strings.FieldsFunc(op.Path, func(r rune) bool {
+		return r == '/' || r == ':'
+	}) {
+		b.WriteString(strings.ToUpper(p[:1]))
+		b.WriteString(p[1:])
+	}
+
+	return b.String()
+}
+
+// exportName turns a wire field/operation name (as found in a struct tag,
+// e.g. "out_1" or "x") into an exported Go identifier, e.g. "Out1" or "X".
+func exportName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.'
+	})
+
+	var b strings.Builder
+	for _, p := range parts {
+		if p == "" {
+			continue
+		}
+		b.WriteString(strings.ToUpper(p[:1]))
+		b.WriteString(p[1:])
+	}
+
+	if b.Len() == 0 {
+		return "Field"
+	}
+
+	return b.String()
+}
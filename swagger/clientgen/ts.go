@@ -0,0 +1,169 @@
+package clientgen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/clubpay/ronycontrib/swagger"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// tsClientGen renders a TypeScript client module using fetch, one function
+// per REST contract, reusing the reflection performed by swagger.BuildModel.
+type tsClientGen struct {
+	tagName string
+}
+
+func NewTSClient() *tsClientGen {
+	return &tsClientGen{tagName: "json"}
+}
+
+func (g *tsClientGen) WithTag(tagName string) *tsClientGen {
+	g.tagName = tagName
+
+	return g
+}
+
+func (g tsClientGen) WriteToFile(filename string, services ...*desc.Service) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	return g.WriteTo(f, services...)
+}
+
+func (g tsClientGen) WriteTo(w io.Writer, services ...*desc.Service) error {
+	m := swagger.BuildModel(g.tagName, services...)
+
+	src := &bytes.Buffer{}
+	fmt.Fprint(src, "// Code generated by swagger/clientgen. DO NOT EDIT.\n\n")
+
+	names := make([]string, 0, len(m.Definitions))
+	for name := range m.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeTSInterface(src, m.Definitions[name])
+	}
+
+	fmt.Fprint(src, `export class APIError extends Error {
+  constructor(public statusCode: number, public body: unknown) {
+    super(`+"`unexpected status ${statusCode}`"+`);
+  }
+}
+
+export class Client {
+  constructor(private baseUrl: string) {}
+
+  private async request<T>(method: string, path: string, body?: unknown): Promise<T> {
+    const res = await fetch(this.baseUrl + path, {
+      method,
+      headers: { "Content-Type": "application/json", Accept: "application/json" },
+      body: body !== undefined ? JSON.stringify(body) : undefined,
+    });
+    if (!res.ok) {
+      throw new APIError(res.status, await res.json().catch(() => undefined));
+    }
+
+    return res.json() as Promise<T>;
+  }
+
+`)
+
+	for _, op := range m.Operations {
+		if op.Method == "" {
+			// Not REST-reachable (e.g. RPC/WebSocket-only); nothing to call over fetch.
+			continue
+		}
+		writeTSMethod(src, op)
+	}
+
+	fmt.Fprint(src, "}\n")
+
+	_, err := w.Write(src.Bytes())
+
+	return err
+}
+
+func writeTSInterface(w io.Writer, def *swagger.Definition) {
+	fmt.Fprintf(w, "export interface %s {\n", def.Name)
+	for _, f := range def.Fields {
+		optional := ""
+		if !f.Required {
+			optional = "?"
+		}
+		fmt.Fprintf(w, "  %s%s: %s;\n", f.Name, optional, tsType(f.Type))
+	}
+	fmt.Fprint(w, "}\n\n")
+}
+
+func writeTSMethod(w io.Writer, op *swagger.Operation) {
+	methodName := tsMethodName(operationName(op))
+
+	fmt.Fprintf(w, "  async %s(in_: %s): Promise<%s> {\n", methodName, op.Input, op.Output)
+	fmt.Fprintf(w, "    let path = %q;\n", op.Path)
+
+	query := make([]string, 0, len(op.Params))
+	for _, p := range op.Params {
+		if p.In == "path" {
+			fmt.Fprintf(w, "    path = path.replace(%q, String(in_.%s));\n", ":"+p.Name, p.Name)
+		} else {
+			query = append(query, p.Name)
+		}
+	}
+
+	if len(query) > 0 {
+		fmt.Fprint(w, "    const q = new URLSearchParams();\n")
+		for _, name := range query {
+			fmt.Fprintf(w, "    q.set(%q, String(in_.%s));\n", name, name)
+		}
+		fmt.Fprint(w, "    path += \"?\" + q.toString();\n")
+	}
+
+	bodyArg := "undefined"
+	switch op.Method {
+	case "POST", "PUT", "PATCH":
+		bodyArg = "in_"
+	}
+
+	fmt.Fprintf(w, "    return this.request<%s>(%q, path, %s);\n", op.Output, op.Method, bodyArg)
+	fmt.Fprint(w, "  }\n\n")
+}
+
+func tsMethodName(name string) string {
+	if name == "" {
+		return "call"
+	}
+
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func tsType(t swagger.FieldType) string {
+	var base string
+	switch t.Kind {
+	case "string":
+		base = "string"
+	case "integer", "number":
+		base = "number"
+	case "boolean":
+		base = "boolean"
+	case "array":
+		base = tsType(*t.Items) + "[]"
+	case "ref":
+		base = t.Ref
+	default:
+		base = "unknown"
+	}
+
+	if t.Nullable {
+		return base + " | null"
+	}
+
+	return base
+}
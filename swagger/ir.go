@@ -0,0 +1,185 @@
+package swagger
+
+import (
+	"reflect"
+	"strings"
+)
+
+// irKind is the scalar shape of an irSchema, independent of any particular
+// spec format (Swagger 2.0, OpenAPI 3.1, ...).
+type irKind int
+
+const (
+	irString irKind = iota
+	irInteger
+	irNumber
+	irBoolean
+	irArray
+	irRef
+	irObject
+)
+
+// irSchema is the format-agnostic description of a single field's type,
+// built once by reflection and rendered by each generator into its own
+// spec representation.
+type irSchema struct {
+	Kind     irKind
+	Format   string
+	Ref      string // referenced definition name, set when Kind == irRef
+	Items    *irSchema
+	Nullable bool
+
+	// Constraints parsed from the swag struct tag (request #2); zero
+	// values mean "unset" rather than "no constraint at zero".
+	Enum    []string
+	Min     *float64
+	Max     *float64
+	MinLen  *int64
+	MaxLen  *int64
+	Pattern string
+	Default string
+	Example string
+}
+
+// irField is a single property of an irDefinition.
+type irField struct {
+	Name     string
+	Schema   *irSchema
+	Required bool
+}
+
+// irDefinition is the reflected shape of a Go struct, keyed by its type
+// name so generators can resolve $ref-style links between definitions.
+type irDefinition struct {
+	Name   string
+	Fields []irField
+}
+
+// schemaBuilder walks desc.Contract input/output/error types once via
+// reflection and produces irDefinitions shared by every spec generator in
+// this package, so the type reflection isn't duplicated per format.
+type schemaBuilder struct {
+	tagName string
+	defs    map[string]*irDefinition
+}
+
+func newSchemaBuilder(tagName string) *schemaBuilder {
+	return &schemaBuilder{
+		tagName: tagName,
+		defs:    map[string]*irDefinition{},
+	}
+}
+
+// definition returns the irDefinition for rType, building and caching it
+// (along with any nested struct types it references) on first use.
+func (b *schemaBuilder) definition(rType reflect.Type) *irDefinition {
+	rType = indirectType(rType)
+
+	if def, ok := b.defs[rType.Name()]; ok {
+		return def
+	}
+
+	def := &irDefinition{Name: rType.Name()}
+	// Register before walking fields, so a struct that (indirectly)
+	// references itself doesn't recurse forever.
+	b.defs[rType.Name()] = def
+
+	for i := 0; i < rType.NumField(); i++ {
+		f := rType.Field(i)
+
+		// An embedded struct with no explicit tag name promotes its
+		// fields onto the parent, following Go's JSON promotion rules.
+		// An embedded struct that *does* carry an explicit tag is just
+		// a regular named field referencing it.
+		if f.Anonymous && f.Tag.Get(b.tagName) == "" && indirectType(f.Type).Kind() == reflect.Struct {
+			embedded := b.definition(indirectType(f.Type))
+			def.Fields = append(def.Fields, embedded.Fields...)
+
+			continue
+		}
+
+		fName := f.Tag.Get(b.tagName)
+		if fName == "" {
+			continue
+		}
+
+		// Drop a trailing `,omitempty` and the like from the tag value.
+		if idx := strings.IndexByte(fName, ','); idx >= 0 {
+			fName = strings.TrimSpace(fName[:idx])
+		}
+
+		meta := parseFieldMeta(f.Tag.Get(swagTagName))
+		if meta.Ignore {
+			continue
+		}
+
+		schema := b.fieldSchema(f.Type)
+		applyFieldMeta(schema, meta)
+		def.Fields = append(def.Fields, irField{Name: fName, Schema: schema, Required: meta.Required})
+	}
+
+	return def
+}
+
+func (b *schemaBuilder) fieldSchema(fType reflect.Type) *irSchema {
+	var nullable bool
+	if fType.Kind() == reflect.Ptr {
+		fType = fType.Elem()
+		nullable = true
+	}
+
+	if fType.Kind() == reflect.Slice && fType.Elem().Kind() != reflect.Uint8 {
+		return &irSchema{Kind: irArray, Items: b.fieldSchema(fType.Elem()), Nullable: nullable}
+	}
+
+	switch fType.Kind() {
+	case reflect.String:
+		return &irSchema{Kind: irString, Nullable: nullable}
+	case reflect.Slice:
+		// []byte, marshaled by encoding/json as a base64 string.
+		return &irSchema{Kind: irString, Format: "byte", Nullable: nullable}
+	case reflect.Int8, reflect.Uint8:
+		return &irSchema{Kind: irInteger, Format: "int8", Nullable: nullable}
+	case reflect.Int32, reflect.Uint32:
+		return &irSchema{Kind: irInteger, Format: "int32", Nullable: nullable}
+	case reflect.Int, reflect.Uint, reflect.Int64, reflect.Uint64:
+		return &irSchema{Kind: irInteger, Format: "int64", Nullable: nullable}
+	case reflect.Float32:
+		return &irSchema{Kind: irNumber, Format: "float", Nullable: nullable}
+	case reflect.Float64:
+		return &irSchema{Kind: irNumber, Format: "double", Nullable: nullable}
+	case reflect.Bool:
+		return &irSchema{Kind: irBoolean, Nullable: nullable}
+	case reflect.Struct:
+		b.definition(fType)
+
+		return &irSchema{Kind: irRef, Ref: fType.Name(), Nullable: nullable}
+	case reflect.Interface:
+		return &irSchema{Kind: irObject, Nullable: nullable}
+	default:
+		return &irSchema{Kind: irString, Nullable: nullable}
+	}
+}
+
+// applyFieldMeta overlays the swag-tag constraints onto a reflected schema.
+func applyFieldMeta(s *irSchema, meta fieldMeta) {
+	s.Enum = meta.Enum
+	s.Min = meta.Min
+	s.Max = meta.Max
+	s.MinLen = meta.MinLen
+	s.MaxLen = meta.MaxLen
+	s.Pattern = meta.Pattern
+	s.Default = meta.Default
+	s.Example = meta.Example
+	if meta.Format != "" {
+		s.Format = meta.Format
+	}
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return t
+}
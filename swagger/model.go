@@ -0,0 +1,258 @@
+package swagger
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/clubpay/ronykit"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// Model is a generator-agnostic reflection of a set of desc.Service trees,
+// built via the same schemaBuilder the Swagger/OpenAPI generators use. It
+// exists so other tools (e.g. swagger/clientgen) can reuse the type
+// reflection performed by schemaBuilder without repeating it.
+type Model struct {
+	Definitions map[string]*Definition
+	Operations  []*Operation
+}
+
+// Definition is the exported form of an irDefinition.
+type Definition struct {
+	Name   string
+	Fields []Field
+}
+
+// Field is the exported form of an irField.
+type Field struct {
+	Name     string
+	Required bool
+	Type     FieldType
+}
+
+// FieldType is the exported form of an irSchema.
+type FieldType struct {
+	Kind     string // "string", "integer", "number", "boolean", "array", "object" or "ref"
+	Format   string
+	Ref      string // referenced Definition name, set when Kind == "ref"
+	Items    *FieldType
+	Nullable bool
+
+	// Constraints parsed from the swag struct tag; zero values mean
+	// "unset" rather than "no constraint at zero".
+	Enum    []string
+	Min     *float64
+	Max     *float64
+	MinLen  *int64
+	MaxLen  *int64
+	Pattern string
+	Default string
+	Example string
+}
+
+// Operation is a single REST- or RPC-reachable desc.Contract.
+type Operation struct {
+	Service   string
+	Name      string
+	Method    string // HTTP method, upper-cased; empty if no REST selector
+	Path      string // raw selector path, e.g. "/some/:x/:y"; empty if no REST selector
+	Predicate string // RPC predicate; empty if no RPC selector
+	Input     string // Definitions key for the input type
+	Output    string // Definitions key for the output type
+	Params    []Param
+	Errors    []OpError
+}
+
+// Param is a single path or query parameter of an Operation, derived from
+// the same path/tag matching rules as setInput.
+type Param struct {
+	Name     string
+	In       string // "path" or "query"
+	Required bool
+	Type     FieldType
+}
+
+// OpError is one of an Operation's possible error responses.
+type OpError struct {
+	Code int
+	Item string
+	Type string // Definitions key for the error payload type
+}
+
+// BuildModel reflects over services the same way the Swagger/OpenAPI
+// generators do and returns a Model describing their REST- and
+// RPC-reachable contracts, ready to be rendered by a client or AsyncAPI
+// generator.
+func BuildModel(tagName string, services ...*desc.Service) *Model {
+	sb := newSchemaBuilder(tagName)
+	m := &Model{Definitions: map[string]*Definition{}}
+
+	for _, s := range services {
+		for _, c := range s.Contracts {
+			inType := reflect.Indirect(reflect.ValueOf(c.Input)).Type()
+			outType := reflect.Indirect(reflect.ValueOf(c.Output)).Type()
+			sb.definition(inType)
+			sb.definition(outType)
+
+			op := &Operation{
+				Service: s.Name,
+				Name:    c.Name,
+				Input:   inType.Name(),
+				Output:  outType.Name(),
+			}
+
+			for _, pe := range c.PossibleErrors {
+				errType := reflect.Indirect(reflect.ValueOf(pe.Message)).Type()
+				sb.definition(errType)
+				op.Errors = append(op.Errors, OpError{Code: pe.Code, Item: pe.Item, Type: errType.Name()})
+			}
+
+			for _, sel := range c.Selectors {
+				if restSel, ok := sel.(ronykit.RESTRouteSelector); ok {
+					op.Method = strings.ToUpper(restSel.GetMethod())
+					op.Path = restSel.GetPath()
+					op.Params = reflectParams(sb, tagName, restSel.GetPath(), inType)
+				}
+
+				if rpcSel, ok := sel.(ronykit.RPCRouteSelector); ok {
+					op.Predicate = rpcSel.GetPredicate()
+				}
+			}
+
+			if op.Method != "" || op.Predicate != "" {
+				m.Operations = append(m.Operations, op)
+			}
+		}
+	}
+
+	for name, def := range sb.defs {
+		m.Definitions[name] = exportDefinition(def)
+	}
+
+	return m
+}
+
+// reflectParams mirrors setInput's path/query placement rules.
+func reflectParams(sb *schemaBuilder, tagName, path string, inType reflect.Type) []Param {
+	inType = indirectType(inType)
+
+	pathParams := map[string]bool{}
+	for _, pp := range strings.Split(path, "/") {
+		if strings.HasPrefix(pp, ":") {
+			pathParams[strings.TrimPrefix(pp, ":")] = true
+		}
+	}
+
+	var params []Param
+	for i := 0; i < inType.NumField(); i++ {
+		f := inType.Field(i)
+
+		fName := f.Tag.Get(tagName)
+		if fName == "" {
+			continue
+		}
+
+		meta := parseFieldMeta(f.Tag.Get(swagTagName))
+		if meta.Ignore {
+			continue
+		}
+
+		in := "query"
+		required := meta.Required
+		if pathParams[fName] {
+			in = "path"
+			required = true
+		}
+
+		schema := sb.fieldSchema(f.Type)
+		applyFieldMeta(schema, meta)
+
+		params = append(params, Param{
+			Name:     fName,
+			In:       in,
+			Required: required,
+			Type:     exportSchema(schema),
+		})
+	}
+
+	return params
+}
+
+func exportDefinition(def *irDefinition) *Definition {
+	d := &Definition{Name: def.Name}
+	for _, f := range def.Fields {
+		d.Fields = append(d.Fields, Field{Name: f.Name, Required: f.Required, Type: exportSchema(f.Schema)})
+	}
+
+	return d
+}
+
+func exportSchema(s *irSchema) FieldType {
+	var items *FieldType
+	if s.Items != nil {
+		it := exportSchema(s.Items)
+		items = &it
+	}
+
+	return FieldType{
+		Kind:     irKindName(s.Kind),
+		Format:   s.Format,
+		Ref:      s.Ref,
+		Items:    items,
+		Nullable: s.Nullable,
+		Enum:     s.Enum,
+		Min:      s.Min,
+		Max:      s.Max,
+		MinLen:   s.MinLen,
+		MaxLen:   s.MaxLen,
+		Pattern:  s.Pattern,
+		Default:  s.Default,
+		Example:  s.Example,
+	}
+}
+
+func irKindName(k irKind) string {
+	switch k {
+	case irString:
+		return "string"
+	case irInteger:
+		return "integer"
+	case irNumber:
+		return "number"
+	case irBoolean:
+		return "boolean"
+	case irArray:
+		return "array"
+	case irRef:
+		return "ref"
+	case irObject:
+		return "object"
+	default:
+		return "string"
+	}
+}
+
+// CoerceEnumValue converts a raw enum literal from a FieldType's Enum slice
+// into the JSON type matching kind (a FieldType.Kind string), so e.g. an
+// integer field's "504" renders as a number rather than a string. Model
+// consumers such as swagger/asyncapi use this to render enums the same way
+// the Swagger/OpenAPI generators do.
+func CoerceEnumValue(kind, v string) interface{} {
+	switch kind {
+	case "integer":
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case "number":
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+
+	return v
+}
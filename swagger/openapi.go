@@ -0,0 +1,449 @@
+package swagger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/clubpay/ronykit"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// openapiGen renders the same []*desc.Service tree as swaggerGen, but into
+// an OpenAPI 3.1 document. Most ronykit consumers feed the spec into tools
+// like oapi-codegen, redoc or Stoplight, which target 3.x rather than
+// Swagger 2.0.
+type openapiGen struct {
+	doc     *oasDocument
+	tagName string
+}
+
+func NewOpenAPI(title, ver, desc string) *openapiGen {
+	og := &openapiGen{
+		doc: &oasDocument{
+			OpenAPI: "3.1.0",
+			Info: oasInfo{
+				Title:       title,
+				Description: desc,
+				Version:     ver,
+			},
+			Paths: map[string]*oasPathItem{},
+			Components: oasComponents{
+				Schemas:         map[string]*oasSchema{},
+				SecuritySchemes: map[string]*oasSecurityScheme{},
+			},
+		},
+	}
+
+	return og
+}
+
+func (og *openapiGen) WithTag(tagName string) *openapiGen {
+	og.tagName = tagName
+
+	return og
+}
+
+// WithServers sets the `servers` entries of the document.
+func (og *openapiGen) WithServers(urls ...string) *openapiGen {
+	for _, u := range urls {
+		og.doc.Servers = append(og.doc.Servers, oasServer{URL: u})
+	}
+
+	return og
+}
+
+// WithBearerAuth registers a bearer-token securityScheme under the given
+// name, ready to be referenced by consumers of the generated document.
+func (og *openapiGen) WithBearerAuth(name string) *openapiGen {
+	og.doc.Components.SecuritySchemes[name] = &oasSecurityScheme{
+		Type:   "http",
+		Scheme: "bearer",
+	}
+
+	return og
+}
+
+func (og openapiGen) WriteToFile(filename string, services ...*desc.Service) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	return og.WriteTo(f, services...)
+}
+
+func (og openapiGen) WriteTo(w io.Writer, services ...*desc.Service) error {
+	sb := newSchemaBuilder(og.tagName)
+
+	for _, s := range services {
+		og.doc.Tags = append(og.doc.Tags, oasTag{Name: s.Name})
+		for _, c := range s.Contracts {
+			og.addOperation(sb, s.Name, c)
+		}
+	}
+
+	for name, def := range sb.defs {
+		og.doc.Components.Schemas[name] = renderOASDefinition(def)
+	}
+
+	out, err := json.MarshalIndent(og.doc, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(out)
+
+	return err
+}
+
+func (og openapiGen) addOperation(sb *schemaBuilder, serviceName string, c desc.Contract) {
+	inType := reflect.Indirect(reflect.ValueOf(c.Input)).Type()
+	outType := reflect.Indirect(reflect.ValueOf(c.Output)).Type()
+	sb.definition(inType)
+	sb.definition(outType)
+
+	op := &oasOperation{
+		OperationID: c.Name,
+		Tags:        []string{serviceName},
+		Responses: map[string]*oasResponse{
+			"200": {
+				Description: "OK",
+				Content: map[string]*oasMediaType{
+					"application/json": {Schema: refSchema(outType.Name())},
+				},
+			},
+		},
+	}
+
+	possibleItems := map[int][]string{}
+	for _, pe := range c.PossibleErrors {
+		errType := reflect.Indirect(reflect.ValueOf(pe.Message)).Type()
+		sb.definition(errType)
+		possibleItems[pe.Code] = append(possibleItems[pe.Code], pe.Item)
+		op.Responses[fmt.Sprintf("%d", pe.Code)] = &oasResponse{
+			Description: fmt.Sprintf("Items: %s", strings.Join(possibleItems[pe.Code], ", ")),
+			Content: map[string]*oasMediaType{
+				"application/json": {Schema: refSchema(errType.Name())},
+			},
+		}
+	}
+
+	for _, sel := range c.Selectors {
+		restSel, ok := sel.(ronykit.RESTRouteSelector)
+		if !ok {
+			continue
+		}
+
+		og.setInput(op, restSel.GetPath(), inType)
+
+		restPath := replacePath(restSel.GetPath())
+		pathItem := og.doc.Paths[restPath]
+		if pathItem == nil {
+			pathItem = &oasPathItem{}
+			og.doc.Paths[restPath] = pathItem
+		}
+
+		switch strings.ToUpper(restSel.GetMethod()) {
+		case http.MethodGet:
+			pathItem.Get = op
+		case http.MethodDelete:
+			pathItem.Delete = op
+		case http.MethodPost:
+			op.RequestBody = bodyOf(inType)
+			pathItem.Post = op
+		case http.MethodPut:
+			op.RequestBody = bodyOf(inType)
+			pathItem.Put = op
+		case http.MethodPatch:
+			op.RequestBody = bodyOf(inType)
+			pathItem.Patch = op
+		}
+	}
+}
+
+func bodyOf(inType reflect.Type) *oasRequestBody {
+	return &oasRequestBody{
+		Required: true,
+		Content: map[string]*oasMediaType{
+			"application/json": {Schema: refSchema(inType.Name())},
+		},
+	}
+}
+
+func (og *openapiGen) setInput(op *oasOperation, path string, inType reflect.Type) {
+	if inType.Kind() == reflect.Ptr {
+		inType = inType.Elem()
+	}
+
+	pathParams := make(map[string]bool)
+	for _, pp := range strings.Split(path, "/") {
+		if strings.HasPrefix(pp, ":") {
+			pathParams[strings.TrimPrefix(pp, ":")] = true
+		}
+	}
+
+	for i := 0; i < inType.NumField(); i++ {
+		f := inType.Field(i)
+		fName := f.Tag.Get(og.tagName)
+		if fName == "" {
+			continue
+		}
+
+		meta := parseFieldMeta(f.Tag.Get(swagTagName))
+		if meta.Ignore {
+			continue
+		}
+
+		in := "query"
+		required := meta.Required
+		if pathParams[fName] {
+			// Path parameters are always required, per the OpenAPI spec.
+			in = "path"
+			required = true
+		}
+
+		op.Parameters = append(op.Parameters, &oasParameter{
+			Name:     fName,
+			In:       in,
+			Required: required,
+			Schema:   paramSchema(f.Type, meta),
+		})
+	}
+}
+
+// renderOASDefinition renders an irDefinition built by schemaBuilder into
+// an OpenAPI 3.1 schema object.
+func renderOASDefinition(def *irDefinition) *oasSchema {
+	schema := &oasSchema{
+		Type:       "object",
+		Properties: map[string]*oasSchema{},
+	}
+	for _, f := range def.Fields {
+		schema.Properties[f.Name] = renderOASSchema(f.Schema)
+		if f.Required {
+			schema.Required = append(schema.Required, f.Name)
+		}
+	}
+
+	return schema
+}
+
+func renderOASSchema(s *irSchema) *oasSchema {
+	var out *oasSchema
+	switch s.Kind {
+	case irString:
+		out = &oasSchema{Type: "string", Format: s.Format}
+	case irInteger:
+		out = &oasSchema{Type: "integer", Format: s.Format}
+	case irNumber:
+		out = &oasSchema{Type: "number", Format: s.Format}
+	case irBoolean:
+		out = &oasSchema{Type: "boolean"}
+	case irArray:
+		out = &oasSchema{Type: "array", Items: renderOASSchema(s.Items)}
+	case irRef:
+		return refSchema(s.Ref)
+	case irObject:
+		out = &oasSchema{Type: "object"}
+	default:
+		out = &oasSchema{Type: "string"}
+	}
+
+	if s.Nullable {
+		out.Type = []interface{}{out.Type, "null"}
+	}
+	if len(s.Enum) > 0 {
+		out.Enum = make([]interface{}, len(s.Enum))
+		for i, v := range s.Enum {
+			out.Enum[i] = coerceEnumValue(s.Kind, v)
+		}
+	}
+	out.Minimum = s.Min
+	out.Maximum = s.Max
+	out.MinLength = s.MinLen
+	out.MaxLength = s.MaxLen
+	out.Pattern = s.Pattern
+	if s.Default != "" {
+		out.Default = coerceEnumValue(s.Kind, s.Default)
+	}
+	if s.Example != "" {
+		out.Example = coerceEnumValue(s.Kind, s.Example)
+	}
+
+	return out
+}
+
+func refSchema(name string) *oasSchema {
+	return &oasSchema{Ref: fmt.Sprintf("#/components/schemas/%s", name)}
+}
+
+func paramSchema(t reflect.Type, meta fieldMeta) *oasSchema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	kind := t.Kind()
+	elemKind := kind
+
+	var out *oasSchema
+	if kind == reflect.Slice {
+		elemKind = t.Elem().Kind()
+		switch elemKind {
+		case reflect.String:
+			out = &oasSchema{Type: "array", Items: &oasSchema{Type: "string"}}
+		case reflect.Float64, reflect.Float32:
+			out = &oasSchema{Type: "array", Items: &oasSchema{Type: "number"}}
+		case reflect.Int8, reflect.Uint8:
+			out = &oasSchema{Type: "array", Items: &oasSchema{Type: "integer", Format: "int8"}}
+		case reflect.Int32, reflect.Uint32:
+			out = &oasSchema{Type: "array", Items: &oasSchema{Type: "integer", Format: "int32"}}
+		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+			out = &oasSchema{Type: "array", Items: &oasSchema{Type: "integer", Format: "int64"}}
+		default:
+			return nil
+		}
+	} else {
+		switch kind {
+		case reflect.String:
+			out = &oasSchema{Type: "string"}
+		case reflect.Float64, reflect.Float32:
+			out = &oasSchema{Type: "number"}
+		case reflect.Int8, reflect.Uint8:
+			out = &oasSchema{Type: "integer", Format: "int8"}
+		case reflect.Int32, reflect.Uint32:
+			out = &oasSchema{Type: "integer", Format: "int32"}
+		case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+			out = &oasSchema{Type: "integer", Format: "int64"}
+		default:
+			return nil
+		}
+	}
+
+	// Constraints apply to the array's items for a repeated param, or to
+	// out itself for a scalar one.
+	target := out
+	if out.Items != nil {
+		target = out.Items
+	}
+
+	if len(meta.Enum) > 0 {
+		target.Enum = make([]interface{}, len(meta.Enum))
+		for i, v := range meta.Enum {
+			target.Enum[i] = coerceParamValue(elemKind, v)
+		}
+	}
+	target.Minimum = meta.Min
+	target.Maximum = meta.Max
+	target.MinLength = meta.MinLen
+	target.MaxLength = meta.MaxLen
+	target.Pattern = meta.Pattern
+	if meta.Default != "" {
+		target.Default = coerceParamValue(elemKind, meta.Default)
+	}
+	if meta.Example != "" {
+		target.Example = coerceParamValue(elemKind, meta.Example)
+	}
+	if meta.Format != "" {
+		target.Format = meta.Format
+	}
+
+	return out
+}
+
+// oasDocument is a minimal OpenAPI 3.1 document, covering the subset this
+// package emits. We hand-roll it instead of depending on a 3.x spec
+// library, since go-openapi/spec only models Swagger 2.0.
+type oasDocument struct {
+	OpenAPI    string                  `json:"openapi"`
+	Info       oasInfo                 `json:"info"`
+	Servers    []oasServer             `json:"servers,omitempty"`
+	Tags       []oasTag                `json:"tags,omitempty"`
+	Paths      map[string]*oasPathItem `json:"paths"`
+	Components oasComponents           `json:"components"`
+}
+
+type oasInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type oasServer struct {
+	URL string `json:"url"`
+}
+
+type oasTag struct {
+	Name string `json:"name"`
+}
+
+type oasComponents struct {
+	Schemas         map[string]*oasSchema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*oasSecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type oasSecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+type oasPathItem struct {
+	Get    *oasOperation `json:"get,omitempty"`
+	Post   *oasOperation `json:"post,omitempty"`
+	Put    *oasOperation `json:"put,omitempty"`
+	Patch  *oasOperation `json:"patch,omitempty"`
+	Delete *oasOperation `json:"delete,omitempty"`
+}
+
+type oasOperation struct {
+	OperationID string                  `json:"operationId"`
+	Tags        []string                `json:"tags,omitempty"`
+	Parameters  []*oasParameter         `json:"parameters,omitempty"`
+	RequestBody *oasRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*oasResponse `json:"responses"`
+}
+
+type oasParameter struct {
+	Name     string     `json:"name"`
+	In       string     `json:"in"`
+	Required bool       `json:"required,omitempty"`
+	Schema   *oasSchema `json:"schema,omitempty"`
+}
+
+type oasRequestBody struct {
+	Required bool                     `json:"required,omitempty"`
+	Content  map[string]*oasMediaType `json:"content"`
+}
+
+type oasResponse struct {
+	Description string                   `json:"description"`
+	Content     map[string]*oasMediaType `json:"content,omitempty"`
+}
+
+type oasMediaType struct {
+	Schema *oasSchema `json:"schema"`
+}
+
+// oasSchema is a JSON Schema fragment as used by OpenAPI 3.1. Type is
+// typically a string, but a nullable field is rendered as a ["T", "null"]
+// array per the 3.1 (plain JSON Schema) convention.
+type oasSchema struct {
+	Type       interface{}           `json:"type,omitempty"`
+	Format     string                `json:"format,omitempty"`
+	Ref        string                `json:"$ref,omitempty"`
+	Items      *oasSchema            `json:"items,omitempty"`
+	Properties map[string]*oasSchema `json:"properties,omitempty"`
+	Required   []string              `json:"required,omitempty"`
+	Enum       []interface{}         `json:"enum,omitempty"`
+	Minimum    *float64              `json:"minimum,omitempty"`
+	Maximum    *float64              `json:"maximum,omitempty"`
+	MinLength  *int64                `json:"minLength,omitempty"`
+	MaxLength  *int64                `json:"maxLength,omitempty"`
+	Pattern    string                `json:"pattern,omitempty"`
+	Default    interface{}           `json:"default,omitempty"`
+	Example    interface{}           `json:"example,omitempty"`
+}
@@ -0,0 +1,62 @@
+package swagger_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/clubpay/ronycontrib/swagger"
+)
+
+func TestNewOpenAPI(t *testing.T) {
+	og := swagger.NewOpenAPI("TestTitle", "v0.0.1", "")
+	og.WithTag("json")
+
+	sb := &strings.Builder{}
+	if err := og.WriteTo(sb, testService{}.Desc()); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Paths map[string]struct {
+			Get *struct {
+				OperationID string `json:"operationId"`
+			} `json:"get"`
+			Post *struct {
+				RequestBody *struct {
+					Content map[string]json.RawMessage `json:"content"`
+				} `json:"requestBody"`
+			} `json:"post"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]struct {
+					Enum []interface{} `json:"enum"`
+				} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal([]byte(sb.String()), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	path, ok := doc.Paths["/some/{x}/{y}"]
+	if !ok {
+		t.Fatalf("expected a path item for /some/{x}/{y}, got %v", doc.Paths)
+	}
+	if path.Get == nil {
+		t.Fatal("expected a GET operation")
+	}
+	if path.Post == nil || path.Post.RequestBody == nil || len(path.Post.RequestBody.Content) == 0 {
+		t.Fatal("expected a requestBody on the POST operation")
+	}
+
+	if _, ok := doc.Components.Schemas["sampleReq"]; !ok {
+		t.Errorf("expected components/schemas to contain sampleReq, got %v", doc.Components.Schemas)
+	}
+
+	enum := doc.Components.Schemas["sampleError"].Properties["code"].Enum
+	if len(enum) != 2 || enum[0] != float64(504) || enum[1] != float64(503) {
+		t.Errorf("expected sampleError.code enum [504 503] as numbers, got %v", enum)
+	}
+}
@@ -0,0 +1,321 @@
+package swagger
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/clubpay/ronykit"
+	"github.com/clubpay/ronykit/desc"
+)
+
+// WritePostmanCollection renders services into a Postman Collection v2.1
+// document using the "json" struct tag, for callers that don't need
+// WithTag. See postmanGen for the configurable form.
+func WritePostmanCollection(w io.Writer, services ...*desc.Service) error {
+	return NewPostman().WithTag("json").WriteTo(w, services...)
+}
+
+// postmanGen renders a Postman Collection v2.1 document: one folder per
+// service, one request per REST selector, with example query strings,
+// bodies and responses derived by reflection, following the same tag rules
+// as schemaBuilder and setInput.
+type postmanGen struct {
+	tagName string
+}
+
+func NewPostman() *postmanGen {
+	return &postmanGen{}
+}
+
+func (pg *postmanGen) WithTag(tagName string) *postmanGen {
+	pg.tagName = tagName
+
+	return pg
+}
+
+func (pg postmanGen) WriteToFile(filename string, services ...*desc.Service) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+
+	return pg.WriteTo(f, services...)
+}
+
+// WriteTo renders services into w. URLs are written against a {{baseUrl}}
+// collection variable.
+func (pg postmanGen) WriteTo(w io.Writer, services ...*desc.Service) error {
+	root := &postmanCollection{
+		Info: postmanInfo{
+			Name:   "API",
+			Schema: "https://schema.getpostman.com/json/collection/v2.1.0/collection.json",
+		},
+	}
+
+	for _, s := range services {
+		folder := &postmanItem{Name: s.Name}
+		for _, c := range s.Contracts {
+			for _, sel := range c.Selectors {
+				restSel, ok := sel.(ronykit.RESTRouteSelector)
+				if !ok {
+					continue
+				}
+
+				folder.Item = append(folder.Item, postmanRequest(pg.tagName, c, restSel))
+			}
+		}
+		root.Item = append(root.Item, folder)
+	}
+
+	out, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(out)
+
+	return err
+}
+
+func postmanRequest(tagName string, c desc.Contract, restSel ronykit.RESTRouteSelector) *postmanItem {
+	inType := reflect.Indirect(reflect.ValueOf(c.Input)).Type()
+	method := strings.ToUpper(restSel.GetMethod())
+
+	item := &postmanItem{
+		Name: c.Name,
+		Request: &postmanRequestDetail{
+			Method: method,
+			URL:    postmanURL(tagName, restSel.GetPath(), inType),
+		},
+	}
+
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		body, _ := json.MarshalIndent(exampleValue(tagName, inType), "", "  ")
+		item.Request.Body = &postmanBody{Mode: "raw", Raw: string(body), Options: postmanBodyOptions{
+			Raw: postmanRawOptions{Language: "json"},
+		}}
+	}
+
+	for _, pe := range c.PossibleErrors {
+		errType := reflect.Indirect(reflect.ValueOf(pe.Message)).Type()
+		body, _ := json.MarshalIndent(exampleValue(tagName, errType), "", "  ")
+		item.Response = append(item.Response, postmanResponse{
+			Name:            pe.Item,
+			OriginalRequest: item.Request,
+			Code:            pe.Code,
+			Status:          http.StatusText(pe.Code),
+			Body:            string(body),
+		})
+	}
+
+	return item
+}
+
+func postmanURL(tagName, path string, inType reflect.Type) *postmanURLDetail {
+	inType = indirectType(inType)
+
+	pathParams := map[string]bool{}
+	for _, pp := range strings.Split(path, "/") {
+		if strings.HasPrefix(pp, ":") {
+			pathParams[strings.TrimPrefix(pp, ":")] = true
+		}
+	}
+
+	u := &postmanURLDetail{
+		Raw:  "{{baseUrl}}" + path,
+		Host: []string{"{{baseUrl}}"},
+	}
+	for _, p := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		u.Path = append(u.Path, p)
+	}
+
+	for i := 0; i < inType.NumField(); i++ {
+		f := inType.Field(i)
+		fName := f.Tag.Get(tagName)
+		if fName == "" {
+			continue
+		}
+
+		meta := parseFieldMeta(f.Tag.Get(swagTagName))
+		if meta.Ignore {
+			continue
+		}
+
+		if pathParams[fName] {
+			u.Variable = append(u.Variable, postmanVariable{
+				Key:   fName,
+				Value: exampleString(tagName, f.Type),
+			})
+		} else {
+			u.Query = append(u.Query, postmanQueryParam{
+				Key:   fName,
+				Value: exampleString(tagName, f.Type),
+			})
+		}
+	}
+
+	return u
+}
+
+// exampleValue reflects t into a map of its fields, each set to its type's
+// zero value, so generated request bodies aren't just "{}". Swag default/
+// example values are not used.
+func exampleValue(tagName string, t reflect.Type) interface{} {
+	return exampleValueSeen(tagName, t, map[reflect.Type]bool{})
+}
+
+// exampleValueSeen is exampleValue's recursive worker. seen tracks the
+// struct types on the current recursion path (not every type visited
+// overall), so a self-referential type like `type Node struct { Next *Node
+// }` stops instead of recursing forever, while the same type used twice in
+// unrelated branches is still expanded both times.
+func exampleValueSeen(tagName string, t reflect.Type, seen map[reflect.Type]bool) interface{} {
+	t = indirectType(t)
+	if t.Kind() != reflect.Struct {
+		return zeroExampleSeen(tagName, t, seen)
+	}
+
+	if seen[t] {
+		return map[string]interface{}{}
+	}
+	seen[t] = true
+	defer delete(seen, t)
+
+	out := map[string]interface{}{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		if f.Anonymous && f.Tag.Get(tagName) == "" && indirectType(f.Type).Kind() == reflect.Struct {
+			if embedded, ok := exampleValueSeen(tagName, f.Type, seen).(map[string]interface{}); ok {
+				for k, v := range embedded {
+					out[k] = v
+				}
+			}
+
+			continue
+		}
+
+		fName := f.Tag.Get(tagName)
+		if fName == "" {
+			continue
+		}
+		if idx := strings.IndexByte(fName, ','); idx >= 0 {
+			fName = strings.TrimSpace(fName[:idx])
+		}
+
+		meta := parseFieldMeta(f.Tag.Get(swagTagName))
+		if meta.Ignore {
+			continue
+		}
+
+		out[fName] = zeroExampleSeen(tagName, f.Type, seen)
+	}
+
+	return out
+}
+
+func zeroExample(tagName string, t reflect.Type) interface{} {
+	return zeroExampleSeen(tagName, t, map[reflect.Type]bool{})
+}
+
+func zeroExampleSeen(tagName string, t reflect.Type, seen map[reflect.Type]bool) interface{} {
+	t = indirectType(t)
+	switch t.Kind() {
+	case reflect.String:
+		return ""
+	case reflect.Bool:
+		return false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return 0
+	case reflect.Float32, reflect.Float64:
+		return 0
+	case reflect.Slice, reflect.Array:
+		return []interface{}{}
+	case reflect.Struct:
+		return exampleValueSeen(tagName, t, seen)
+	default:
+		return nil
+	}
+}
+
+func exampleString(tagName string, t reflect.Type) string {
+	switch v := zeroExample(tagName, t).(type) {
+	case string:
+		return v
+	default:
+		b, _ := json.Marshal(v)
+
+		return string(b)
+	}
+}
+
+// postmanCollection is a minimal Postman Collection v2.1 document, covering
+// the subset this package emits.
+type postmanCollection struct {
+	Info postmanInfo    `json:"info"`
+	Item []*postmanItem `json:"item"`
+}
+
+type postmanInfo struct {
+	Name   string `json:"name"`
+	Schema string `json:"schema"`
+}
+
+type postmanItem struct {
+	Name     string                `json:"name"`
+	Item     []*postmanItem        `json:"item,omitempty"`
+	Request  *postmanRequestDetail `json:"request,omitempty"`
+	Response []postmanResponse     `json:"response,omitempty"`
+}
+
+type postmanRequestDetail struct {
+	Method string            `json:"method"`
+	URL    *postmanURLDetail `json:"url"`
+	Body   *postmanBody      `json:"body,omitempty"`
+}
+
+type postmanURLDetail struct {
+	Raw      string              `json:"raw"`
+	Host     []string            `json:"host"`
+	Path     []string            `json:"path,omitempty"`
+	Query    []postmanQueryParam `json:"query,omitempty"`
+	Variable []postmanVariable   `json:"variable,omitempty"`
+}
+
+type postmanQueryParam struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type postmanVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type postmanBody struct {
+	Mode    string             `json:"mode"`
+	Raw     string             `json:"raw"`
+	Options postmanBodyOptions `json:"options"`
+}
+
+type postmanBodyOptions struct {
+	Raw postmanRawOptions `json:"raw"`
+}
+
+type postmanRawOptions struct {
+	Language string `json:"language"`
+}
+
+type postmanResponse struct {
+	Name            string                `json:"name"`
+	OriginalRequest *postmanRequestDetail `json:"originalRequest,omitempty"`
+	Code            int                   `json:"code"`
+	Status          string                `json:"status"`
+	Body            string                `json:"body"`
+}
@@ -0,0 +1,94 @@
+package swagger_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/clubpay/ronycontrib/swagger"
+	"github.com/clubpay/ronykit/desc"
+	"github.com/clubpay/ronykit/std/gateway/fasthttp"
+)
+
+func TestWritePostmanCollection(t *testing.T) {
+	buf := &bytes.Buffer{}
+	if err := swagger.WritePostmanCollection(buf, testService{}.Desc()); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Item []struct {
+			Name string `json:"name"`
+			Item []struct {
+				Name    string `json:"name"`
+				Request struct {
+					Method string `json:"method"`
+					URL    struct {
+						Raw string `json:"raw"`
+					} `json:"url"`
+				} `json:"request"`
+				Response []struct {
+					Code int `json:"code"`
+				} `json:"response"`
+			} `json:"item"`
+		} `json:"item"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(doc.Item) != 1 || doc.Item[0].Name != "testService" {
+		t.Fatalf("expected one folder named testService, got %v", doc.Item)
+	}
+
+	reqs := doc.Item[0].Item
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 requests, got %d: %v", len(reqs), reqs)
+	}
+
+	get := reqs[0]
+	if get.Request.Method != "GET" || get.Request.URL.Raw != "{{baseUrl}}/some/:x/:y" {
+		t.Errorf("unexpected GET request: %+v", get)
+	}
+	if len(get.Response) != 2 {
+		t.Errorf("expected 2 example responses for the possible errors, got %d", len(get.Response))
+	}
+}
+
+// selfRefReq has a self-referential field, regression coverage for the
+// exampleValue/zeroExample cycle guard.
+type selfRefReq struct {
+	Name string      `json:"name"`
+	Next *selfRefReq `json:"next"`
+}
+
+type selfRefRes struct {
+	OK bool `json:"ok"`
+}
+
+func TestWritePostmanCollection_SelfReferentialType(t *testing.T) {
+	svc := (&desc.Service{Name: "selfRefService"}).
+		AddContract(
+			desc.NewContract().
+				AddSelector(fasthttp.Selector{Method: fasthttp.MethodPost, Path: "/self"}).
+				SetInput(&selfRefReq{}).
+				SetOutput(&selfRefRes{}).
+				SetHandler(nil),
+		)
+
+	buf := &bytes.Buffer{}
+	done := make(chan error, 1)
+	go func() {
+		done <- swagger.WritePostmanCollection(buf, svc)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WritePostmanCollection did not return, likely recursing on the self-referential type")
+	}
+}
@@ -51,13 +51,22 @@ func (sg swaggerGen) WriteToFile(filename string, services ...*desc.Service) err
 }
 
 func (sg swaggerGen) WriteTo(w io.Writer, services ...*desc.Service) error {
+	sb := newSchemaBuilder(sg.tagName)
+
 	for _, s := range services {
 		addTag(sg.s, s)
 		for _, c := range s.Contracts {
-			sg.addOperation(sg.s, s.Name, c)
+			sg.addOperation(sg.s, sb, s.Name, c)
 		}
 	}
 
+	if sg.s.Definitions == nil {
+		sg.s.Definitions = map[string]spec.Schema{}
+	}
+	for name, def := range sb.defs {
+		sg.s.Definitions[name] = renderSwaggerDefinition(def)
+	}
+
 	swaggerJSON, err := sg.s.MarshalJSON()
 	if err != nil {
 		return err
@@ -68,7 +77,7 @@ func (sg swaggerGen) WriteTo(w io.Writer, services ...*desc.Service) error {
 	return err
 }
 
-func (sg swaggerGen) addOperation(swag *spec.Swagger, serviceName string, c desc.Contract) {
+func (sg swaggerGen) addOperation(swag *spec.Swagger, sb *schemaBuilder, serviceName string, c desc.Contract) {
 	if swag.Paths == nil {
 		swag.Paths = &spec.Paths{
 			Paths: map[string]spec.PathItem{},
@@ -77,6 +86,8 @@ func (sg swaggerGen) addOperation(swag *spec.Swagger, serviceName string, c desc
 
 	inType := reflect.Indirect(reflect.ValueOf(c.Input)).Type()
 	outType := reflect.Indirect(reflect.ValueOf(c.Output)).Type()
+	sb.definition(inType)
+	sb.definition(outType)
 
 	opID := c.Name
 	op := spec.NewOperation(opID).
@@ -94,7 +105,7 @@ func (sg swaggerGen) addOperation(swag *spec.Swagger, serviceName string, c desc
 	possibleErrors := map[int][]string{}
 	for _, pe := range c.PossibleErrors {
 		errType := reflect.Indirect(reflect.ValueOf(pe.Message)).Type()
-		sg.addDefinition(swag, errType)
+		sb.definition(errType)
 		possibleErrors[pe.Code] = append(possibleErrors[pe.Code], pe.Item)
 		op.RespondsWith(
 			pe.Code,
@@ -112,8 +123,6 @@ func (sg swaggerGen) addOperation(swag *spec.Swagger, serviceName string, c desc
 		}
 
 		sg.setInput(op, restSel.GetPath(), inType)
-		sg.addDefinition(swag, inType)
-		sg.addDefinition(swag, outType)
 
 		restPath := replacePath(restSel.GetPath())
 		pathItem := swag.Paths.Paths[restPath]
@@ -166,10 +175,17 @@ func (sg *swaggerGen) setInput(op *spec.Operation, path string, inType reflect.T
 	}
 
 	for i := 0; i < inType.NumField(); i++ {
-		fName := inType.Field(i).Tag.Get(sg.tagName)
+		f := inType.Field(i)
+		fName := f.Tag.Get(sg.tagName)
 		if fName == "" {
 			continue
 		}
+
+		meta := parseFieldMeta(f.Tag.Get(swagTagName))
+		if meta.Ignore {
+			continue
+		}
+
 		found := false
 		for _, pathParam := range pathParams {
 			if fName == pathParam {
@@ -178,23 +194,24 @@ func (sg *swaggerGen) setInput(op *spec.Operation, path string, inType reflect.T
 		}
 
 		if found {
+			// Path parameters are always required, per the Swagger/OpenAPI spec.
 			op.AddParam(
 				setParameter(
 					spec.PathParam(fName).
 						AsRequired().
 						NoEmptyValues(),
-					inType.Field(i).Type,
+					f.Type,
+					meta,
 				),
 			)
 		} else {
-			op.AddParam(
-				setParameter(
-					spec.QueryParam(fName).
-						AsRequired().
-						NoEmptyValues(),
-					inType.Field(i).Type,
-				),
-			)
+			p := spec.QueryParam(fName).NoEmptyValues()
+			if meta.Required {
+				p = p.AsRequired()
+			} else {
+				p = p.AsOptional()
+			}
+			op.AddParam(setParameter(p, f.Type, meta))
 		}
 	}
 }
@@ -206,92 +223,105 @@ func addTag(swag *spec.Swagger, s *desc.Service) {
 	)
 }
 
-func (sg *swaggerGen) addDefinition(swag *spec.Swagger, rType reflect.Type) {
-	if rType.Kind() == reflect.Ptr {
-		rType = rType.Elem()
-	}
+// renderSwaggerDefinition renders an irDefinition built by schemaBuilder
+// into a Swagger 2.0 schema object.
+func renderSwaggerDefinition(def *irDefinition) spec.Schema {
+	schema := spec.Schema{}
+	schema.Typed("object", "")
 
-	if swag.Definitions == nil {
-		swag.Definitions = map[string]spec.Schema{}
+	for _, f := range def.Fields {
+		schema.SetProperty(f.Name, renderSwaggerSchema(f.Schema))
+		if f.Required {
+			schema.Required = append(schema.Required, f.Name)
+		}
 	}
 
-	def := spec.Schema{}
-	def.Typed("object", "")
-
-	for i := 0; i < rType.NumField(); i++ {
-		f := rType.Field(i)
-		fType := f.Type
-		fName := f.Tag.Get(sg.tagName)
-		if fName == "" {
-			continue
-		}
+	return schema
+}
 
-		// This is a hack to remove omitempty from tags
-		fNameParts := strings.Split(fName, ",")
-		if len(fNameParts) > 1 {
-			fName = strings.TrimSpace(fNameParts[0])
+func renderSwaggerSchema(s *irSchema) spec.Schema {
+	var prop *spec.Schema
+	switch s.Kind {
+	case irString:
+		if s.Format != "" {
+			prop = spec.StrFmtProperty(s.Format)
+		} else {
+			prop = spec.StringProperty()
 		}
-
-		var wrapFunc func(schema *spec.Schema) spec.Schema
-		switch fType.Kind() {
-		case reflect.Ptr:
-			fType = fType.Elem()
-			wrapFunc = func(schema *spec.Schema) spec.Schema {
-				return *schema
-			}
-		case reflect.Slice:
-			wrapFunc = func(item *spec.Schema) spec.Schema {
-				return *spec.ArrayProperty(item)
-			}
-			fType = fType.Elem()
+	case irInteger:
+		switch s.Format {
+		case "int8":
+			prop = spec.Int8Property()
+		case "int32":
+			prop = spec.Int32Property()
 		default:
-			wrapFunc = func(schema *spec.Schema) spec.Schema {
-				return *schema
-			}
+			prop = spec.Int64Property()
 		}
+	case irNumber:
+		if s.Format == "float" {
+			prop = spec.Float32Property()
+		} else {
+			prop = spec.Float64Property()
+		}
+	case irBoolean:
+		prop = spec.BoolProperty()
+	case irArray:
+		item := renderSwaggerSchema(s.Items)
+		prop = spec.ArrayProperty(&item)
+	case irRef:
+		prop = spec.RefProperty(fmt.Sprintf("#/definitions/%s", s.Ref))
+	case irObject:
+		prop = &spec.Schema{}
+		prop.Typed("object", "")
+	default:
+		prop = spec.StringProperty()
+	}
 
-	Switch:
-		switch fType.Kind() {
-		case reflect.String:
-			def.SetProperty(fName, wrapFunc(spec.StringProperty()))
-		case reflect.Int8, reflect.Uint8:
-			def.SetProperty(fName, wrapFunc(spec.ArrayProperty(spec.Int8Property())))
-		case reflect.Int32, reflect.Uint32:
-			def.SetProperty(fName, wrapFunc(spec.Int32Property()))
-		case reflect.Int, reflect.Uint, reflect.Int64, reflect.Uint64:
-			def.SetProperty(fName, wrapFunc(spec.Int64Property()))
-		case reflect.Float32:
-			def.SetProperty(fName, wrapFunc(spec.Float32Property()))
-		case reflect.Float64:
-			def.SetProperty(fName, wrapFunc(spec.Float64Property()))
-		case reflect.Struct:
-			def.SetProperty(fName, wrapFunc(spec.RefProperty(fmt.Sprintf("#/definitions/%s", fType.Name()))))
-			sg.addDefinition(swag, fType)
-		case reflect.Bool:
-			def.SetProperty(fName, wrapFunc(spec.BoolProperty()))
-		case reflect.Interface:
-			sub := &spec.Schema{}
-			sub.Typed("object", "")
-			def.SetProperty(fName, wrapFunc(sub))
-		case reflect.Ptr:
-			fType = fType.Elem()
-
-			goto Switch
-
-		default:
-			fmt.Println(fType.Kind())
-			def.SetProperty(fName, wrapFunc(spec.StringProperty()))
+	if s.Nullable {
+		prop = prop.AsNullable()
+	}
+	if len(s.Enum) > 0 {
+		enum := make([]interface{}, len(s.Enum))
+		for i, v := range s.Enum {
+			enum[i] = coerceEnumValue(s.Kind, v)
 		}
+		prop.WithEnum(enum...)
+	}
+	if s.Min != nil {
+		prop.WithMinimum(*s.Min, false)
+	}
+	if s.Max != nil {
+		prop.WithMaximum(*s.Max, false)
+	}
+	if s.MinLen != nil {
+		prop.WithMinLength(*s.MinLen)
+	}
+	if s.MaxLen != nil {
+		prop.WithMaxLength(*s.MaxLen)
+	}
+	if s.Pattern != "" {
+		prop.WithPattern(s.Pattern)
+	}
+	if s.Default != "" {
+		prop.WithDefault(s.Default)
+	}
+	if s.Example != "" {
+		prop.WithExample(s.Example)
 	}
 
-	swag.Definitions[rType.Name()] = def
+	return *prop
 }
 
-func setParameter(p *spec.Parameter, t reflect.Type) *spec.Parameter {
+func setParameter(p *spec.Parameter, t reflect.Type, meta fieldMeta) *spec.Parameter {
 	kind := t.Kind()
+	elemKind := kind
+	if kind == reflect.Slice {
+		elemKind = t.Elem().Kind()
+	}
+
 	switch kind {
 	case reflect.Slice:
-		switch t.Elem().Kind() {
+		switch elemKind {
 		case reflect.String:
 			p.Typed("string", kind.String())
 		case reflect.Float64, reflect.Float32:
@@ -319,6 +349,35 @@ func setParameter(p *spec.Parameter, t reflect.Type) *spec.Parameter {
 		return nil
 	}
 
+	if len(meta.Enum) > 0 {
+		enum := make([]interface{}, len(meta.Enum))
+		for i, v := range meta.Enum {
+			enum[i] = coerceParamValue(elemKind, v)
+		}
+		p.WithEnum(enum...)
+	}
+	if meta.Min != nil {
+		p.WithMinimum(*meta.Min, false)
+	}
+	if meta.Max != nil {
+		p.WithMaximum(*meta.Max, false)
+	}
+	if meta.MinLen != nil {
+		p.WithMinLength(*meta.MinLen)
+	}
+	if meta.MaxLen != nil {
+		p.WithMaxLength(*meta.MaxLen)
+	}
+	if meta.Pattern != "" {
+		p.WithPattern(meta.Pattern)
+	}
+	if meta.Default != "" {
+		p.WithDefault(coerceParamValue(elemKind, meta.Default))
+	}
+	if meta.Format != "" {
+		p.Format = meta.Format
+	}
+
 	return p
 }
 
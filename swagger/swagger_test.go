@@ -86,3 +86,34 @@ func TestNewSwagger(t *testing.T) {
     x, _ := json.MarshalIndent(json.RawMessage(sb.String()), "", "   ")
     fmt.Println(string(x))
 }
+
+func TestNewSwagger_EmbeddedFields(t *testing.T) {
+    sg := swagger.NewSwagger("TestTitle", "v0.0.1", "")
+    sg.WithTag("json")
+
+    sb := &strings.Builder{}
+    err := sg.WriteTo(sb, testService{}.Desc())
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    var doc struct {
+        Definitions map[string]struct {
+            Properties map[string]json.RawMessage `json:"properties"`
+        } `json:"definitions"`
+    }
+    if err := json.Unmarshal([]byte(sb.String()), &doc); err != nil {
+        t.Fatal(err)
+    }
+
+    def, ok := doc.Definitions["anotherRes"]
+    if !ok {
+        t.Fatal("expected a definition for anotherRes")
+    }
+
+    for _, fName := range []string{"some", "another", "out1", "out2"} {
+        if _, ok := def.Properties[fName]; !ok {
+            t.Errorf("anotherRes is missing promoted/own field %q", fName)
+        }
+    }
+}
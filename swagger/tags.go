@@ -0,0 +1,132 @@
+package swagger
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// swagTagName is the struct tag key carrying the compound constraint
+// language honored by every generator in this package, e.g.:
+//
+//	Code int `json:"code" swag:"enum:504,503"`
+const swagTagName = "swag"
+
+// fieldMeta is the parsed form of a field's swag tag.
+type fieldMeta struct {
+	Required bool
+	Ignore   bool
+	Enum     []string
+	Min      *float64
+	Max      *float64
+	MinLen   *int64
+	MaxLen   *int64
+	Pattern  string
+	Default  string
+	Example  string
+	Format   string
+}
+
+// parseFieldMeta parses the compound, semicolon-separated constraint
+// language used by the swag tag, e.g. `required;min:1;max:10` or
+// `enum:504,503`.
+func parseFieldMeta(tag string) fieldMeta {
+	var meta fieldMeta
+	if tag == "" {
+		return meta
+	}
+
+	for _, part := range strings.Split(tag, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		key := part
+		val := ""
+		if idx := strings.IndexByte(part, ':'); idx >= 0 {
+			key = part[:idx]
+			val = part[idx+1:]
+		}
+
+		switch key {
+		case "required":
+			meta.Required = true
+		case "ignore":
+			meta.Ignore = true
+		case "enum":
+			meta.Enum = strings.Split(val, ",")
+		case "min":
+			if f, err := strconv.ParseFloat(val, 64); err == nil {
+				meta.Min = &f
+			}
+		case "max":
+			if f, err := strconv.ParseFloat(val, 64); err == nil {
+				meta.Max = &f
+			}
+		case "minLen":
+			if n, err := strconv.ParseInt(val, 10, 64); err == nil {
+				meta.MinLen = &n
+			}
+		case "maxLen":
+			if n, err := strconv.ParseInt(val, 10, 64); err == nil {
+				meta.MaxLen = &n
+			}
+		case "pattern":
+			meta.Pattern = val
+		case "default":
+			meta.Default = val
+		case "example":
+			meta.Example = val
+		case "format":
+			meta.Format = val
+		}
+	}
+
+	return meta
+}
+
+// coerceEnumValue converts a raw enum literal from a swag tag into the JSON
+// type matching kind, so e.g. `swag:"enum:504,503"` on an integer field
+// renders as a number rather than a string in the generated spec.
+func coerceEnumValue(kind irKind, v string) interface{} {
+	switch kind {
+	case irInteger:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case irNumber:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	case irBoolean:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+
+	return v
+}
+
+// coerceParamValue is coerceEnumValue's counterpart for setParameter/
+// paramSchema, which reflect path/query param types directly rather than
+// going through schemaBuilder's irSchema.
+func coerceParamValue(kind reflect.Kind, v string) interface{} {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return n
+		}
+	case reflect.Float32, reflect.Float64:
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	case reflect.Bool:
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+
+	return v
+}